@@ -0,0 +1,111 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// boundSubStructs maps the conventional Path/Query/Body/Headers sub-struct
+// field names a DTO may use to separate its parameters to the bind call
+// that fills each one from its own request source.
+var boundSubStructs = map[string]func(c *gin.Context, target interface{}) error{
+	"Path":    func(c *gin.Context, target interface{}) error { return c.ShouldBindUri(target) },
+	"Query":   bindQueryTag,
+	"Headers": func(c *gin.Context, target interface{}) error { return c.ShouldBindHeader(target) },
+	"Body":    func(c *gin.Context, target interface{}) error { return c.ShouldBind(target) },
+}
+
+// bindParams fills target's fields from the request in a single pass. If
+// target has one or more Path/Query/Body/Headers sub-structs, each is bound
+// from its own source using its own tags. Otherwise target is treated as a
+// flat DTO, and each of ShouldBindUri/bindQueryTag/ShouldBindHeader/ShouldBind
+// is invoked only when target declares a field with the matching tag
+// (uri, query, header, and form/json/xml respectively). Every source is
+// attempted regardless of earlier failures, and their errors are merged into
+// a single error so a client sees every invalid source at once.
+func (r *Router) bindParams(c *gin.Context, target interface{}) error {
+	val := reflect.ValueOf(target).Elem()
+	t := val.Type()
+
+	var errs []error
+
+	nested := false
+	for i := 0; i < t.NumField(); i++ {
+		bind, ok := boundSubStructs[t.Field(i).Name]
+		if !ok {
+			continue
+		}
+		nested = true
+
+		sub, err := addressableStruct(val.Field(i))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := bind(c, sub); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if nested {
+		return errors.Join(errs...)
+	}
+
+	if hasTag(t, "uri") {
+		if err := c.ShouldBindUri(target); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if hasTag(t, "query") {
+		if err := bindQueryTag(c, target); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if hasTag(t, "header") {
+		if err := c.ShouldBindHeader(target); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if hasTag(t, "form") || hasTag(t, "json") || hasTag(t, "xml") {
+		if err := c.ShouldBind(target); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// addressableStruct returns an addressable pointer to a struct field,
+// allocating it first if it is a nil pointer.
+func addressableStruct(v reflect.Value) (interface{}, error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return v.Interface(), nil
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("field must be a struct or pointer to struct, got %v", v.Kind())
+	}
+	return v.Addr().Interface(), nil
+}
+
+// hasTag reports whether any field of t declares tagName.
+func hasTag(t reflect.Type, tagName string) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if _, ok := t.Field(i).Tag.Lookup(tagName); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// bindQueryTag binds target's `query`-tagged fields from the request's
+// query string, mirroring ShouldBindQuery but keyed off the "query" tag
+// used elsewhere in this package instead of gin's default "form" tag.
+func bindQueryTag(c *gin.Context, target interface{}) error {
+	return binding.MapFormWithTag(target, c.Request.URL.Query(), "query")
+}
@@ -0,0 +1,129 @@
+package router
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type userUpdateParams struct {
+	Path struct {
+		ID int `uri:"id"`
+	}
+	Query struct {
+		Verbose bool `query:"verbose"`
+	}
+	Body struct {
+		Name string `json:"name" binding:"required"`
+	}
+}
+
+func TestCreateHandlerFunc_NestedSubStructs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := NewRouter()
+	r.AddRoute("user:update", "/users/:id", func(c *gin.Context, p *userUpdateParams) {
+		c.JSON(http.StatusOK, gin.H{
+			"id":      p.Path.ID,
+			"verbose": p.Query.Verbose,
+			"name":    p.Body.Name,
+		})
+	}, Post)
+
+	body := bytes.NewBufferString(`{"name":"Alice"}`)
+	req := httptest.NewRequest(http.MethodPost, "/users/42?verbose=1", body)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"id":42,"verbose":true,"name":"Alice"}`, w.Body.String())
+}
+
+func TestCreateHandlerFunc_NestedSubStructs_BodyValidationError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := NewRouter()
+	r.AddRoute("user:update", "/users/:id", func(c *gin.Context, p *userUpdateParams) {
+		c.JSON(http.StatusOK, gin.H{"id": p.Path.ID})
+	}, Post)
+
+	body := bytes.NewBufferString(`{}`)
+	req := httptest.NewRequest(http.MethodPost, "/users/42", body)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+type flatParams struct {
+	ID   int    `uri:"id"`
+	Sort string `query:"sort"`
+}
+
+func TestCreateHandlerFunc_FlatDTO(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := NewRouter()
+	r.AddRoute("items:list", "/items/:id", func(c *gin.Context, p *flatParams) {
+		c.JSON(http.StatusOK, gin.H{"id": p.ID, "sort": p.Sort})
+	}, Get)
+
+	req := httptest.NewRequest(http.MethodGet, "/items/7?sort=name", nil)
+	w := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"id":7,"sort":"name"}`, w.Body.String())
+}
+
+func TestCreateHandlerFunc_MergesErrorsFromMultipleSources(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var bindErr error
+	r := NewRouter()
+	r.SetBindErrorHandler(func(c *gin.Context, err error) {
+		bindErr = err
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	})
+	r.AddRoute("user:update", "/users/:id", func(c *gin.Context, p *userUpdateParams) {
+		c.JSON(http.StatusOK, gin.H{"id": p.Path.ID})
+	}, Post)
+
+	body := bytes.NewBufferString(`{}`)
+	req := httptest.NewRequest(http.MethodPost, "/users/not-a-number", body)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	if assert.Error(t, bindErr) {
+		assert.Contains(t, bindErr.Error(), "not-a-number")
+		assert.Contains(t, bindErr.Error(), "'Name'")
+	}
+}
+
+func TestRouter_SetBindErrorHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := NewRouter()
+	r.SetBindErrorHandler(func(c *gin.Context, err error) {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"validation_error": err.Error()})
+	})
+	r.AddRoute("user:update", "/users/:id", func(c *gin.Context, p *userUpdateParams) {
+		c.JSON(http.StatusOK, gin.H{"id": p.Path.ID})
+	}, Post)
+
+	body := bytes.NewBufferString(`{}`)
+	req := httptest.NewRequest(http.MethodPost, "/users/42", body)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
@@ -0,0 +1,98 @@
+package router
+
+import (
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CleanPath normalizes a URL path: it collapses duplicate slashes and
+// resolves "." and ".." segments, preserving a trailing slash when the
+// original path had one.
+func CleanPath(p string) string {
+	if p == "" {
+		return "/"
+	}
+	if p[0] != '/' {
+		p = "/" + p
+	}
+
+	trailingSlash := len(p) > 1 && strings.HasSuffix(p, "/")
+
+	cleaned := path.Clean(p)
+	if trailingSlash && !strings.HasSuffix(cleaned, "/") {
+		cleaned += "/"
+	}
+
+	return cleaned
+}
+
+// toggleTrailingSlash adds or removes a single trailing slash from p.
+func toggleTrailingSlash(p string) string {
+	if strings.HasSuffix(p, "/") {
+		return strings.TrimSuffix(p, "/")
+	}
+	return p + "/"
+}
+
+// PathCleanMiddleware normalizes the request path. NewRouter installs it as
+// the gin engine's first middleware, so it runs after gin's own lookup, in
+// the chain of the matched route or of NoRoute alike; a request that gin
+// redirects itself for a trailing slash never reaches it. It always redirects
+// duplicate slashes and "."/".." segments to their canonical form, and
+// additionally honors RedirectTrailingSlash and RedirectFixedPath when no
+// route matches the canonical path as-is.
+func (r *Router) PathCleanMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		original := c.Request.URL.Path
+		cleaned := CleanPath(original)
+
+		if cleaned != original {
+			r.redirect(c, cleaned)
+			return
+		}
+
+		// The route tree ignores trailing slashes and gin's table does not, so
+		// a tree match with no gin match (empty FullPath) is not an exact one.
+		if _, _, err := r.Match(c.Request.Method, cleaned); err == nil && (c.FullPath() != "" || !r.RedirectTrailingSlash) {
+			c.Next()
+			return
+		}
+
+		if r.RedirectTrailingSlash {
+			if altered := toggleTrailingSlash(cleaned); altered != cleaned {
+				if _, _, err := r.Match(c.Request.Method, altered); err == nil {
+					r.redirect(c, altered)
+					return
+				}
+			}
+		}
+
+		if r.RedirectFixedPath {
+			if fixed, ok := r.tree.matchInsensitive(cleaned); ok && fixed != cleaned {
+				r.redirect(c, fixed)
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// redirect sends a 301 (GET/HEAD) or 308 (other methods) redirect to to,
+// preserving the original query string, and aborts the current chain.
+func (r *Router) redirect(c *gin.Context, to string) {
+	code := http.StatusMovedPermanently
+	if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+		code = http.StatusPermanentRedirect
+	}
+
+	if q := c.Request.URL.RawQuery; q != "" {
+		to += "?" + q
+	}
+
+	c.Redirect(code, to)
+	c.Abort()
+}
@@ -0,0 +1,82 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCleanPath(t *testing.T) {
+	assert.Equal(t, "/a/c", CleanPath("//a/./b/../c"))
+	assert.Equal(t, "/foo/", CleanPath("/foo/"))
+	assert.Equal(t, "/foo", CleanPath("/foo"))
+	assert.Equal(t, "/", CleanPath(""))
+}
+
+func TestRouter_PathCleanRedirect(t *testing.T) {
+	r := NewRouter()
+	r.AddRouteGet("home", "/a/c", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "ok"})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "//a/./b/../c", nil)
+	w := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "/a/c", w.Header().Get("Location"))
+}
+
+func TestRouter_RedirectTrailingSlash(t *testing.T) {
+	r := NewRouter()
+	r.RedirectTrailingSlash = true
+	r.AddRouteGet("foo", "/foo", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "ok"})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/foo/", nil)
+	w := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "/foo", w.Header().Get("Location"))
+}
+
+func TestRouter_RedirectFixedPath(t *testing.T) {
+	r := NewRouter()
+	r.RedirectFixedPath = true
+	r.AddRouteGet("foo", "/Foo/Bar", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "ok"})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/foo/bar", nil)
+	w := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "/Foo/Bar", w.Header().Get("Location"))
+}
+
+func TestRouter_PathCleanInteractionWith404(t *testing.T) {
+	r := NewRouter()
+	r.SetErrorHandler(http.StatusNotFound, func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Custom 404"})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "//unknown", nil)
+	w := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "/unknown", w.Header().Get("Location"))
+
+	req2 := httptest.NewRequest(http.MethodGet, "/unknown", nil)
+	w2 := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w2, req2)
+
+	assert.Equal(t, http.StatusNotFound, w2.Code)
+	assert.JSONEq(t, `{"error":"Custom 404"}`, w2.Body.String())
+}
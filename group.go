@@ -0,0 +1,86 @@
+package router
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RouteGroup is a prefixed collection of routes that share a middleware
+// chain, created via Router.Group.
+type RouteGroup struct {
+	router     *Router
+	prefix     string
+	middleware []gin.HandlerFunc
+}
+
+// Group creates a RouteGroup nested under prefix, inheriting mw as
+// middleware applied to every route added through it.
+func (r *Router) Group(prefix string, mw ...gin.HandlerFunc) *RouteGroup {
+	return &RouteGroup{
+		router:     r,
+		prefix:     prefix,
+		middleware: mw,
+	}
+}
+
+// Use appends middleware to the group's chain, inherited by every route
+// added after the call (and by any child groups created afterwards).
+func (g *RouteGroup) Use(mw ...gin.HandlerFunc) *RouteGroup {
+	g.middleware = append(g.middleware, mw...)
+	return g
+}
+
+// Group creates a child RouteGroup, inheriting this group's prefix and middleware.
+func (g *RouteGroup) Group(prefix string, mw ...gin.HandlerFunc) *RouteGroup {
+	child := &RouteGroup{
+		router:     g.router,
+		prefix:     joinPattern(g.prefix, prefix),
+		middleware: make([]gin.HandlerFunc, len(g.middleware)),
+	}
+	copy(child.middleware, g.middleware)
+	child.middleware = append(child.middleware, mw...)
+	return child
+}
+
+// AddRoute adds a route to the router under this group's prefix and
+// middleware chain.
+func (g *RouteGroup) AddRoute(name string, pattern string, handler interface{}, method Method) *RouteGroup {
+	route := NewRoute(name, joinPattern(g.prefix, pattern), handler, method, map[string]*Route{})
+	route.Use(g.middleware...)
+	g.router.AddRouteObject(route)
+
+	return g
+}
+
+func (g *RouteGroup) AddRouteGet(name string, pattern string, handler interface{}) *RouteGroup {
+	return g.AddRoute(name, pattern, handler, Get)
+}
+
+func (g *RouteGroup) AddRoutePost(name string, pattern string, handler interface{}) *RouteGroup {
+	return g.AddRoute(name, pattern, handler, Post)
+}
+
+func (g *RouteGroup) AddRoutePatch(name string, pattern string, handler interface{}) *RouteGroup {
+	return g.AddRoute(name, pattern, handler, Patch)
+}
+
+func (g *RouteGroup) AddRouteDelete(name string, pattern string, handler interface{}) *RouteGroup {
+	return g.AddRoute(name, pattern, handler, Delete)
+}
+
+func (g *RouteGroup) AddRoutePut(name string, pattern string, handler interface{}) *RouteGroup {
+	return g.AddRoute(name, pattern, handler, Put)
+}
+
+// joinPattern joins a group prefix and a route pattern with exactly one slash.
+func joinPattern(prefix, pattern string) string {
+	switch {
+	case prefix == "":
+		return pattern
+	case pattern == "":
+		return prefix
+	default:
+		return strings.TrimRight(prefix, "/") + "/" + strings.TrimLeft(pattern, "/")
+	}
+}
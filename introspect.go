@@ -0,0 +1,56 @@
+package router
+
+import (
+	"reflect"
+	"runtime"
+	"sort"
+)
+
+// RouteInfo is a read-only snapshot of a registered route, returned by
+// Router.ListRegisteredRoutes for introspection and documentation tooling.
+type RouteInfo struct {
+	Method      Method
+	Pattern     string
+	Name        string
+	HandlerName string
+	Meta        RouteMeta
+}
+
+// ListRegisteredRoutes returns the routes registered on the router, one per
+// route name, sorted by name for stable output. A route registered under a
+// name already in use replaces the earlier one and only the later is listed.
+func (r *Router) ListRegisteredRoutes() []RouteInfo {
+	infos := make([]RouteInfo, 0, len(r.routes))
+
+	for _, route := range r.routes {
+		infos = append(infos, RouteInfo{
+			Method:      route.method,
+			Pattern:     route.pattern,
+			Name:        route.name,
+			HandlerName: handlerName(route.handler),
+			Meta:        route.meta,
+		})
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Name < infos[j].Name
+	})
+
+	return infos
+}
+
+// handlerName resolves a handler func's fully-qualified name via
+// runtime.FuncForPC, or "" if handler isn't a func.
+func handlerName(handler interface{}) string {
+	v := reflect.ValueOf(handler)
+	if v.Kind() != reflect.Func {
+		return ""
+	}
+
+	fn := runtime.FuncForPC(v.Pointer())
+	if fn == nil {
+		return ""
+	}
+
+	return fn.Name()
+}
@@ -0,0 +1,87 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouter_ListRegisteredRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := NewRouter()
+	r.AddRoute("user:detail", "/users/:id", func(c *gin.Context) {}, Get).
+		GetRoutes()["user:detail"].
+		WithMeta(RouteMeta{Tags: []string{"users"}, Summary: "Get a user"})
+
+	infos := r.ListRegisteredRoutes()
+	if assert.Len(t, infos, 1) {
+		assert.Equal(t, "user:detail", infos[0].Name)
+		assert.Equal(t, Get, infos[0].Method)
+		assert.Equal(t, "/users/:id", infos[0].Pattern)
+		assert.Equal(t, "Get a user", infos[0].Meta.Summary)
+		assert.Equal(t, []string{"users"}, infos[0].Meta.Tags)
+		assert.NotEmpty(t, infos[0].HandlerName)
+	}
+}
+
+func TestRouter_ListRegisteredRoutes_PrefixedRouteList(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := NewRouter()
+	list := CreateRouteList("/api/v1")
+	list.Add("product:detail", "/products/:id", func(c *gin.Context) {}, Get)
+	r.AddRouteList(list)
+
+	infos := r.ListRegisteredRoutes()
+	if assert.Len(t, infos, 1) {
+		assert.Equal(t, "/api/v1/products/:id", infos[0].Pattern)
+	}
+
+	route := r.GetRoutes()["product:detail"]
+	u, err := route.URL(map[string]interface{}{"id": 42})
+	assert.NoError(t, err)
+	assert.Equal(t, "/api/v1/products/42", u)
+}
+
+type getUserParams struct {
+	Path struct {
+		ID int `uri:"id"`
+	}
+	Query struct {
+		Verbose bool `query:"verbose"`
+	}
+}
+
+func TestRouter_ServeOpenAPI(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := NewRouter()
+	r.AddRoute("user:detail", "/users/:id", func(c *gin.Context, p *getUserParams) {
+		c.JSON(http.StatusOK, gin.H{"id": p.Path.ID})
+	}, Get).GetRoutes()["user:detail"].WithMeta(RouteMeta{Summary: "Get a user"})
+	r.ServeOpenAPI("/openapi.json")
+
+	req := httptest.NewRequest(http.MethodGet, "/openapi.json", nil)
+	w := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	doc := r.generateOpenAPI()
+	item, ok := doc.Paths["/users/{id}"]
+	if assert.True(t, ok) {
+		op, ok := item["get"]
+		if assert.True(t, ok) {
+			assert.Equal(t, "Get a user", op.Summary)
+			if assert.Len(t, op.Parameters, 2) {
+				names := []string{op.Parameters[0].Name, op.Parameters[1].Name}
+				assert.Contains(t, names, "id")
+				assert.Contains(t, names, "verbose")
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package router
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMethod_String(t *testing.T) {
+	assert.Equal(t, http.MethodGet, Get.String())
+	assert.Equal(t, http.MethodPost, Post.String())
+	assert.Equal(t, http.MethodHead, Head.String())
+	assert.Equal(t, http.MethodPut, Put.String())
+	assert.Equal(t, http.MethodDelete, Delete.String())
+	assert.Equal(t, http.MethodPatch, Patch.String())
+	assert.Equal(t, http.MethodOptions, Options.String())
+	assert.Equal(t, http.MethodConnect, Connect.String())
+	assert.Equal(t, http.MethodTrace, Trace.String())
+}
+
+func TestRouter_AddRouteConnectAndTrace(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := NewRouter()
+	assert.NotPanics(t, func() {
+		r.AddRouteConnect("proxy", "/proxy", func(c *gin.Context) {})
+		r.AddRouteTrace("trace", "/trace", func(c *gin.Context) {})
+	})
+}
@@ -0,0 +1,115 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func namedMiddleware(order *[]string, name string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		*order = append(*order, name)
+		c.Next()
+	}
+}
+
+func TestRoute_UseAbortsBeforeHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	handlerCalled := false
+
+	r := NewRouter()
+	route := NewRoute("secret", "/secret", func(c *gin.Context) {
+		handlerCalled = true
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	}, Get, map[string]*Route{})
+	route.Use(func(c *gin.Context) {
+		c.AbortWithStatus(http.StatusForbidden)
+	})
+	r.AddRouteObject(route)
+
+	req := httptest.NewRequest(http.MethodGet, "/secret", nil)
+	w := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.False(t, handlerCalled)
+}
+
+func TestRouteList_UseInheritance(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var order []string
+
+	parent := CreateRouteList("/api")
+	child := CreateRouteList("/api/v1")
+	parent.AddChild(child)
+
+	parent.Use(namedMiddleware(&order, "parent"))
+	child.Add("ping", "/ping", func(c *gin.Context) {
+		order = append(order, "handler")
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	}, Get)
+
+	r := NewRouter()
+	r.AddRouteList(parent)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/ping", nil)
+	w := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"parent", "handler"}, order)
+}
+
+func TestRouter_GlobalGroupRouteMiddlewareOrder(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var order []string
+
+	r := NewRouter()
+	r.Use(namedMiddleware(&order, "global"))
+
+	list := CreateRouteList("/api")
+	list.Use(namedMiddleware(&order, "group"))
+
+	route := NewRoute("ping", "/ping", func(c *gin.Context) {
+		order = append(order, "handler")
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	}, Get, map[string]*Route{})
+	route.Use(namedMiddleware(&order, "route"))
+	list.AddRoute(route)
+
+	r.AddRouteList(list)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/ping", nil)
+	w := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"global", "group", "route", "handler"}, order)
+}
+
+func TestRouter_ErrorHandlerMiddlewareRunsAfterUserMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	r := NewRouter()
+	r.SetErrorHandler(http.StatusForbidden, func(c *gin.Context) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "Custom 403"})
+	})
+	r.Use(r.ErrorHandlerMiddleware())
+	r.Use(func(c *gin.Context) {
+		c.AbortWithStatus(http.StatusForbidden)
+	})
+
+	r.AddRouteGet("secret", "/secret", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/secret", nil)
+	w := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.JSONEq(t, `{"error":"Custom 403"}`, w.Body.String())
+}
@@ -0,0 +1,84 @@
+// Package openapi defines a minimal OpenAPI 3.0 document model used to
+// describe routes registered on a github.com/gouef/router Router. It holds
+// only the document shape and JSON tags; synthesizing a Document from a
+// Router's routes is done by Router.ServeOpenAPI in the root package, to
+// avoid an import cycle between the two packages.
+package openapi
+
+// Document is a minimal OpenAPI 3.0 document.
+type Document struct {
+	OpenAPI string              `json:"openapi"`
+	Info    Info                `json:"info"`
+	Paths   map[string]PathItem `json:"paths"`
+}
+
+// Info carries the document's title and version, per the OpenAPI spec.
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// PathItem maps a lowercase HTTP method ("get", "post", ...) to the
+// Operation served at that path for that method.
+type PathItem map[string]Operation
+
+// Operation describes a single path+method endpoint.
+type Operation struct {
+	Summary     string              `json:"summary,omitempty"`
+	Tags        []string            `json:"tags,omitempty"`
+	OperationID string              `json:"operationId,omitempty"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// Parameter describes a path, query or header parameter.
+type Parameter struct {
+	Name     string `json:"name"`
+	In       string `json:"in"` // "path", "query" or "header"
+	Required bool   `json:"required"`
+	Schema   Schema `json:"schema"`
+}
+
+// RequestBody describes an operation's request body, keyed by media type.
+type RequestBody struct {
+	Content map[string]MediaType `json:"content"`
+}
+
+// MediaType pairs a request/response body with its schema.
+type MediaType struct {
+	Schema Schema `json:"schema"`
+}
+
+// Response describes a single status code's response.
+type Response struct {
+	Description string `json:"description"`
+}
+
+// Schema is a minimal JSON Schema, enough to describe the struct-tag-derived
+// DTOs this package generates documentation for.
+type Schema struct {
+	Type       string            `json:"type,omitempty"`
+	Properties map[string]Schema `json:"properties,omitempty"`
+	Items      *Schema           `json:"items,omitempty"`
+}
+
+// NewDocument creates an empty Document with the given title and version.
+func NewDocument(title, version string) *Document {
+	return &Document{
+		OpenAPI: "3.0.0",
+		Info:    Info{Title: title, Version: version},
+		Paths:   make(map[string]PathItem),
+	}
+}
+
+// AddOperation registers op under path for method (e.g. "get"), creating
+// the path's entry if this is its first operation.
+func (d *Document) AddOperation(path, method string, op Operation) {
+	item, ok := d.Paths[path]
+	if !ok {
+		item = make(PathItem)
+		d.Paths[path] = item
+	}
+	item[method] = op
+}
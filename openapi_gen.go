@@ -0,0 +1,233 @@
+package router
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gouef/router/openapi"
+)
+
+// ServeOpenAPI mounts a GET handler at path that serves a minimal OpenAPI
+// 3.0 document synthesized from every registered route's pattern, method
+// and DTO struct tags. The document is regenerated on each request so it
+// always reflects the routes currently registered.
+func (r *Router) ServeOpenAPI(path string) *Router {
+	r.router.GET(path, func(c *gin.Context) {
+		c.JSON(http.StatusOK, r.generateOpenAPI())
+	})
+	return r
+}
+
+// generateOpenAPI walks every registered route, reflecting on its handler's
+// DTO parameter (the same one createHandlerFunc binds into) to synthesize
+// path/query/header parameters and a request body schema alongside its
+// Route.WithMeta metadata.
+func (r *Router) generateOpenAPI() *openapi.Document {
+	doc := openapi.NewDocument("API", "1.0.0")
+
+	for _, info := range r.ListRegisteredRoutes() {
+		op := openapi.Operation{
+			Summary:     info.Meta.Summary,
+			Tags:        info.Meta.Tags,
+			OperationID: info.Name,
+			Responses:   responsesFromMeta(info.Meta),
+		}
+
+		if dtoType, ok := handlerDTOType(r.routes[info.Name].handler); ok {
+			op.Parameters = dtoParameters(dtoType)
+			if body, ok := dtoRequestBody(dtoType); ok {
+				op.RequestBody = &openapi.RequestBody{
+					Content: map[string]openapi.MediaType{
+						"application/json": {Schema: body},
+					},
+				}
+			}
+		}
+
+		doc.AddOperation(openAPIPath(info.Pattern), strings.ToLower(info.Method.String()), op)
+	}
+
+	return doc
+}
+
+// responsesFromMeta converts a route's declared response statuses into
+// OpenAPI responses, defaulting to a bare 200 when none were declared.
+func responsesFromMeta(meta RouteMeta) map[string]openapi.Response {
+	responses := make(map[string]openapi.Response, len(meta.Responses))
+	for status := range meta.Responses {
+		responses[strconv.Itoa(status)] = openapi.Response{Description: http.StatusText(status)}
+	}
+	if len(responses) == 0 {
+		responses["200"] = openapi.Response{Description: http.StatusText(http.StatusOK)}
+	}
+	return responses
+}
+
+// openAPIPath rewrites a route pattern's ":name"/":name|regex"/"*name"
+// segments into OpenAPI's "{name}" placeholder form.
+func openAPIPath(pattern string) string {
+	segments := splitPattern(pattern)
+	if len(segments) == 0 {
+		return "/"
+	}
+
+	converted := make([]string, len(segments))
+	for i, seg := range segments {
+		switch {
+		case strings.HasPrefix(seg, "*"):
+			converted[i] = "{" + seg[1:] + "}"
+		case strings.HasPrefix(seg, ":"):
+			spec := strings.TrimSuffix(strings.TrimPrefix(seg, ":"), "?")
+			name, _, _ := strings.Cut(spec, "|")
+			converted[i] = "{" + name + "}"
+		default:
+			converted[i] = seg
+		}
+	}
+
+	return "/" + strings.Join(converted, "/")
+}
+
+// handlerDTOType returns the struct type a generic handler (func(c
+// *gin.Context, p *T)) binds its second parameter into, if it has one.
+func handlerDTOType(handler interface{}) (reflect.Type, bool) {
+	t := reflect.TypeOf(handler)
+	if t == nil || t.Kind() != reflect.Func || t.NumIn() != 2 {
+		return nil, false
+	}
+
+	paramType := t.In(1)
+	if paramType.Kind() != reflect.Ptr || paramType.Elem().Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	return paramType.Elem(), true
+}
+
+// dtoParameters synthesizes path/query/header parameters from a DTO type,
+// following the same Path/Query/Headers sub-struct convention (or flat
+// uri/query/header tags) that bindParams binds at request time.
+func dtoParameters(t reflect.Type) []openapi.Parameter {
+	var params []openapi.Parameter
+	nested := false
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		switch field.Name {
+		case "Path":
+			params = append(params, tagParameters(elemType(field.Type), "uri", "path")...)
+			nested = true
+		case "Query":
+			params = append(params, tagParameters(elemType(field.Type), "query", "query")...)
+			nested = true
+		case "Headers":
+			params = append(params, tagParameters(elemType(field.Type), "header", "header")...)
+			nested = true
+		}
+	}
+	if nested {
+		return params
+	}
+
+	params = append(params, tagParameters(t, "uri", "path")...)
+	params = append(params, tagParameters(t, "query", "query")...)
+	params = append(params, tagParameters(t, "header", "header")...)
+	return params
+}
+
+// dtoRequestBody returns the request body schema for a DTO type, taken from
+// its Body sub-struct if present, or, when any of its fields is json-tagged,
+// from all of its own exported fields.
+func dtoRequestBody(t reflect.Type) (openapi.Schema, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Name == "Body" {
+			return schemaForType(elemType(field.Type)), true
+		}
+	}
+	if hasTag(t, "json") {
+		return schemaForType(t), true
+	}
+	return openapi.Schema{}, false
+}
+
+// elemType dereferences t if it is a pointer.
+func elemType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+	return t
+}
+
+// tagParameters builds one openapi.Parameter per field of t declaring
+// tagName, placed in in ("path", "query" or "header").
+func tagParameters(t reflect.Type, tagName, in string) []openapi.Parameter {
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var params []openapi.Parameter
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag, ok := field.Tag.Lookup(tagName)
+		if !ok {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		params = append(params, openapi.Parameter{
+			Name:     name,
+			In:       in,
+			Required: in == "path",
+			Schema:   schemaForType(field.Type),
+		})
+	}
+	return params
+}
+
+// schemaForType derives a minimal JSON Schema from a Go type.
+func schemaForType(t reflect.Type) openapi.Schema {
+	t = elemType(t)
+
+	switch t.Kind() {
+	case reflect.String:
+		return openapi.Schema{Type: "string"}
+	case reflect.Bool:
+		return openapi.Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return openapi.Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return openapi.Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		item := schemaForType(t.Elem())
+		return openapi.Schema{Type: "array", Items: &item}
+	case reflect.Struct:
+		props := make(map[string]openapi.Schema, t.NumField())
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			props[jsonFieldName(field)] = schemaForType(field.Type)
+		}
+		return openapi.Schema{Type: "object", Properties: props}
+	default:
+		return openapi.Schema{Type: "string"}
+	}
+}
+
+// jsonFieldName returns field's effective JSON name: its json tag's name
+// portion if present, else its Go field name.
+func jsonFieldName(field reflect.StructField) string {
+	if tag, ok := field.Tag.Lookup("json"); ok {
+		name, _, _ := strings.Cut(tag, ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
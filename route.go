@@ -0,0 +1,180 @@
+package router
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+type Route struct {
+	name     string
+	pattern  string
+	handler  interface{}
+	method   Method
+	children map[string]*Route
+
+	middleware []gin.HandlerFunc
+	host       string
+	headers    map[string]string
+	queries    map[string]string
+	schemes    []string
+
+	meta RouteMeta
+}
+
+// RouteMeta holds documentation metadata attached to a route via
+// Route.WithMeta. It is surfaced through Router.ListRegisteredRoutes and
+// consumed by the openapi sub-package to synthesize an OpenAPI document.
+type RouteMeta struct {
+	Tags      []string
+	Summary   string
+	Responses map[int]interface{}
+}
+
+func NewRoute(name string, pattern string, handler interface{}, method Method, children map[string]*Route) *Route {
+	return &Route{
+		name:     name,
+		pattern:  pattern,
+		handler:  handler,
+		method:   method,
+		children: children,
+	}
+}
+
+func (r *Route) GetName() string {
+	return r.name
+}
+
+func (r *Route) GetMethod() Method {
+	return r.method
+}
+
+func (r *Route) GetChildren() map[string]*Route {
+	return r.children
+}
+
+func (r *Route) GetPattern() string {
+	return r.pattern
+}
+
+func (r *Route) GetHandler() interface{} {
+	return r.handler
+}
+
+// URL builds this route's URL by substituting params into its pattern. See
+// GenerateUrlByPattern for the accepted param and query forms.
+func (r *Route) URL(params interface{}, query ...url.Values) (string, error) {
+	return GenerateUrlByPattern(r.pattern, params, query...)
+}
+
+func (r *Route) AddChild(name string, pattern string, handler interface{}, method Method) *Route {
+	child := NewRoute(r.name+":"+name, pattern, handler, method, nil)
+	r.children[pattern] = child
+
+	return r
+}
+
+// Use appends middleware to this route's handler chain.
+func (r *Route) Use(mw ...gin.HandlerFunc) *Route {
+	r.middleware = append(r.middleware, mw...)
+	return r
+}
+
+// Host constrains the route to requests whose Host header matches pattern.
+// pattern is either an exact host or a "*.example.com" style wildcard.
+func (r *Route) Host(pattern string) *Route {
+	r.host = pattern
+	return r
+}
+
+// Headers constrains the route to requests carrying all of the given
+// header/value pairs.
+func (r *Route) Headers(headers map[string]string) *Route {
+	r.headers = headers
+	return r
+}
+
+// Queries constrains the route to requests whose query string contains key=value.
+func (r *Route) Queries(key, value string) *Route {
+	if r.queries == nil {
+		r.queries = make(map[string]string)
+	}
+	r.queries[key] = value
+	return r
+}
+
+// Schemes constrains the route to the given URL schemes, e.g. Schemes("https").
+func (r *Route) Schemes(schemes ...string) *Route {
+	r.schemes = schemes
+	return r
+}
+
+// WithMeta attaches documentation metadata to this route.
+func (r *Route) WithMeta(meta RouteMeta) *Route {
+	r.meta = meta
+	return r
+}
+
+// GetMeta returns this route's documentation metadata.
+func (r *Route) GetMeta() RouteMeta {
+	return r.meta
+}
+
+// hasMatchers reports whether this route has any host/header/query/scheme
+// matcher that needs to be checked against the live request.
+func (r *Route) hasMatchers() bool {
+	return r.host != "" || len(r.headers) > 0 || len(r.queries) > 0 || len(r.schemes) > 0
+}
+
+// matchesRequest checks the route's matchers against an incoming gin.Context.
+func (r *Route) matchesRequest(c *gin.Context) bool {
+	if r.host != "" && !matchHost(r.host, c.Request.Host) {
+		return false
+	}
+
+	for key, value := range r.headers {
+		if c.GetHeader(key) != value {
+			return false
+		}
+	}
+
+	for key, value := range r.queries {
+		if c.Query(key) != value {
+			return false
+		}
+	}
+
+	if len(r.schemes) > 0 {
+		scheme := "http"
+		if c.Request.TLS != nil {
+			scheme = "https"
+		}
+		matched := false
+		for _, s := range r.schemes {
+			if s == scheme {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchHost compares a request Host header against a host pattern. A pattern
+// starting with "*." matches any subdomain of the remainder.
+func matchHost(pattern, host string) bool {
+	if h, _, ok := strings.Cut(host, ":"); ok {
+		host = h
+	}
+
+	if strings.HasPrefix(pattern, "*.") {
+		return strings.HasSuffix(host, pattern[1:])
+	}
+
+	return pattern == host
+}
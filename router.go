@@ -6,7 +6,9 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gouef/mode"
 	"net/http"
+	"net/url"
 	"reflect"
+	"strings"
 )
 
 const (
@@ -21,22 +23,42 @@ const (
 type ErrorHandlerFunc func(c *gin.Context)
 
 type Router struct {
-	router         *gin.Engine
-	routes         map[string]*Route
-	middlewares    []interface{}
-	errorHandlers  map[int]ErrorHandlerFunc
-	defaultHandler ErrorHandlerFunc
-	mode           *mode.Mode
+	router           *gin.Engine
+	routes           map[string]*Route
+	tree             *node
+	middlewares      []gin.HandlerFunc
+	errorHandlers    map[int]ErrorHandlerFunc
+	defaultHandler   ErrorHandlerFunc
+	bindErrorHandler func(c *gin.Context, err error)
+	mode             *mode.Mode
+
+	// nativeRegistered tracks which method+pattern combinations already have
+	// a gin route bound to them, keyed by "METHOD pattern". gin allows only
+	// one handler per method+path, so routes that share a path and are only
+	// distinguished by a matcher (see Route.hasMatchers) must not each try
+	// to register their own; see ensureNativeDispatch.
+	nativeRegistered map[string]bool
+
+	// RedirectTrailingSlash, when true, redirects to a registered route that
+	// only differs from the request path by a trailing slash. Off by default.
+	// The gin engine's own RedirectTrailingSlash (on in gin.New) is separate:
+	// it redirects such requests before PathCleanMiddleware sees them.
+	RedirectTrailingSlash bool
+	// RedirectFixedPath, when true, redirects to a registered route found by
+	// a case-insensitive path lookup. Off by default.
+	RedirectFixedPath bool
 }
 
 // NewRouter create new Router
 func NewRouter() *Router {
 	router := gin.New()
 	m, _ := mode.NewBasicMode()
-	return &Router{
-		router:        router,
-		routes:        make(map[string]*Route),
-		errorHandlers: make(map[int]ErrorHandlerFunc),
+	r := &Router{
+		router:           router,
+		routes:           make(map[string]*Route),
+		tree:             newNode(),
+		errorHandlers:    make(map[int]ErrorHandlerFunc),
+		nativeRegistered: make(map[string]bool),
 		defaultHandler: func(c *gin.Context) {
 			status := c.Writer.Status()
 			c.JSON(status, gin.H{
@@ -46,6 +68,48 @@ func NewRouter() *Router {
 		},
 		mode: m,
 	}
+	router.Use(r.PathCleanMiddleware())
+
+	return r
+}
+
+// Use registers global middleware, prepended to the handler chain of every
+// route registered after the call, ahead of any group- or route-level
+// middleware. Routes registered before the call do not get it.
+func (r *Router) Use(mw ...gin.HandlerFunc) *Router {
+	r.middlewares = append(r.middlewares, mw...)
+	return r
+}
+
+// Match looks up the route registered for method and url against the route
+// tree, without touching the underlying gin engine. It returns
+// ErrRouteNotFound when no route owns the path, or a *MethodNotAllowedError
+// when the path exists but not for method. Match models path+method only: if
+// several routes share a path+method and are distinguished solely by a
+// Host/Headers/Queries/Schemes matcher (see Route.Host and friends), it
+// returns whichever was registered first, since it has no live request to
+// test them against. Serving an actual request selects correctly between
+// them; see dispatch.
+func (r *Router) Match(method string, url string) (*Route, Params, error) {
+	m, ok := FromString(method)
+	if !ok {
+		return nil, nil, ErrRouteNotFound
+	}
+
+	path := url
+	if idx := strings.IndexByte(path, '?'); idx >= 0 {
+		path = path[:idx]
+	}
+
+	return r.tree.match(m, path)
+}
+
+// PrintRoutes walks the route tree and prints every registered route's
+// method, pattern and name.
+func (r *Router) PrintRoutes() {
+	r.tree.walk(func(route *Route) {
+		fmt.Printf("%-7s %-40s %s\n", route.method.String(), route.pattern, route.name)
+	})
 }
 
 func (r *Router) SetDefaultErrorHandler(handler ErrorHandlerFunc) *Router {
@@ -57,6 +121,24 @@ func (r *Router) SetDefaultErrorHandler(handler ErrorHandlerFunc) *Router {
 	return r
 }
 
+// SetBindErrorHandler customizes the response written when a generic
+// handler's param struct fails to bind. By default, a bind failure writes a
+// 400 JSON response with the error's message.
+func (r *Router) SetBindErrorHandler(handler func(c *gin.Context, err error)) *Router {
+	r.bindErrorHandler = handler
+	return r
+}
+
+// handleBindError reports a param-binding failure through the router's
+// custom bind error handler, or a default 400 JSON response.
+func (r *Router) handleBindError(c *gin.Context, err error) {
+	if r.bindErrorHandler != nil {
+		r.bindErrorHandler(c, err)
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
+
 // GetRoutes return list of routes
 func (r *Router) GetRoutes() map[string]*Route {
 	return r.routes
@@ -114,36 +196,101 @@ func (r *Router) ErrorHandlerMiddleware() gin.HandlerFunc {
 //		router := NewRouter()
 //		router.AddRouteList(lr)
 func (r *Router) AddRouteList(l *RouteList) *Router {
+	return r.addRouteList(l, nil)
+}
+
+// addRouteList registers l's own routes under the global middleware,
+// inherited (the accumulated middleware chain of its ancestors) and l's own
+// middleware, then recurses into children with inherited plus l's own
+// middleware so descendants inherit it too.
+func (r *Router) addRouteList(l *RouteList, inherited []gin.HandlerFunc) *Router {
+	chain := append(append([]gin.HandlerFunc{}, inherited...), l.middleware...)
+
 	var group *gin.RouterGroup
 	if l.pattern != "" {
 		group = r.router.Group(l.pattern)
 	}
 
 	for _, route := range l.routes {
+		prefix := append(append([]gin.HandlerFunc{}, r.middlewares...), chain...)
 		if group != nil {
-			createNativeRoute(*group, route)
+			fullPattern := joinPattern(l.pattern, route.pattern)
+			r.ensureNativeDispatch(group, route.pattern, fullPattern, route.method, prefix)
+			route.pattern = fullPattern
 			r.routes[route.name] = route
+			r.tree.insert(route.pattern, route)
 		} else {
-			r.AddRoute(route.name, route.pattern, route.handler, route.method)
+			r.registerRoute(route, prefix)
 		}
 	}
 
-	if l.children != nil {
-		for _, child := range l.children {
-			r.AddRouteList(child)
-		}
+	for _, child := range l.children {
+		r.addRouteList(child, chain)
 	}
 
 	return r
 }
 
-// createHandlerFunc internal, add route to group, and return gin.IRoutes
-func createNativeRoute(g gin.RouterGroup, route *Route) gin.IRoutes {
-	return g.Handle(route.method.String(), route.pattern, createHandlerFunc(route.handler))
+// ensureNativeDispatch registers, at most once per method+fullPattern, a
+// single gin route that resolves the actual Route to run via the route tree
+// at request time (see dispatch). gin allows only one handler per
+// method+path, so a second route sharing fullPattern with an earlier one —
+// typically a Host/Headers/Queries/Schemes variant of the same path — must
+// not try to register again; it is folded into the existing native route
+// and told apart from its siblings by dispatch/matchesRequest instead.
+// relativePattern is the pattern to hand to gin itself: fullPattern when g
+// is the root router, or the route's own pattern (without the group's
+// prefix, which g already carries) when g is a group.
+func (r *Router) ensureNativeDispatch(g *gin.RouterGroup, relativePattern, fullPattern string, method Method, prefix []gin.HandlerFunc) {
+	key := method.String() + " " + fullPattern
+	if r.nativeRegistered[key] {
+		return
+	}
+	r.nativeRegistered[key] = true
+
+	chain := append(append([]gin.HandlerFunc{}, prefix...), r.dispatch(method))
+	if g != nil {
+		g.Handle(method.String(), relativePattern, chain...)
+	} else {
+		r.router.Handle(method.String(), relativePattern, chain...)
+	}
+}
+
+// dispatch returns the gin.HandlerFunc registered for every route sharing
+// method+pattern. It re-resolves the live request against the route tree,
+// picking the first candidate in registration order whose matchers (if any)
+// accept the request, then runs that candidate's own middleware and handler.
+// This is what lets host/header/query/scheme-distinguished routes share a
+// single gin registration instead of colliding in gin's own route table.
+func (r *Router) dispatch(method Method) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route, _, err := r.tree.matchAccept(method, c.Request.URL.Path, func(route *Route) bool {
+			return !route.hasMatchers() || route.matchesRequest(c)
+		})
+		if err != nil {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+
+		chain := make([]gin.HandlerFunc, 0, len(route.middleware)+1)
+		chain = append(chain, route.middleware...)
+		chain = append(chain, r.createHandlerFunc(route.handler))
+		runHandlers(c, chain)
+	}
+}
+
+// runHandlers runs handlers in order, stopping as soon as one aborts c.
+func runHandlers(c *gin.Context, handlers []gin.HandlerFunc) {
+	for _, h := range handlers {
+		if c.IsAborted() {
+			return
+		}
+		h(c)
+	}
 }
 
 // createHandlerFunc internal, create gin.HandlerFunc
-func createHandlerFunc(handler interface{}) gin.HandlerFunc {
+func (r *Router) createHandlerFunc(handler interface{}) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		handlerType := reflect.TypeOf(handler)
 		if handlerType.Kind() != reflect.Func || handlerType.NumIn() != 2 {
@@ -161,9 +308,8 @@ func createHandlerFunc(handler interface{}) gin.HandlerFunc {
 		paramElemType := paramType.Elem()
 		paramValue := reflect.New(paramElemType).Interface()
 
-		err := c.ShouldBindUri(paramValue)
-		if err != nil {
-			c.JSON(400, gin.H{"error": err.Error()})
+		if err := r.bindParams(c, paramValue); err != nil {
+			r.handleBindError(c, err)
 			return
 		}
 
@@ -192,9 +338,24 @@ func createHandlerFunc(handler interface{}) gin.HandlerFunc {
 //		})
 //	}, Get)
 func (r *Router) AddRoute(name string, pattern string, handler interface{}, method Method) *Router {
-	r.routes[name] = NewRoute(name, pattern, handler, method, map[string]*Route{})
+	return r.addRouteObject(NewRoute(name, pattern, handler, method, map[string]*Route{}))
+}
 
-	r.router.Handle(method.String(), pattern, createHandlerFunc(handler))
+// addRouteObject registers an already-built Route (preserving any middleware
+// or matchers set on it) against both the gin engine and the route tree,
+// ahead of which the router's global middleware is applied.
+func (r *Router) addRouteObject(route *Route) *Router {
+	return r.registerRoute(route, r.middlewares)
+}
+
+// registerRoute registers route against both the gin engine and the route
+// tree, with prefix (global and/or inherited group middleware) applied
+// ahead of the route's own middleware.
+func (r *Router) registerRoute(route *Route, prefix []gin.HandlerFunc) *Router {
+	r.routes[route.name] = route
+	r.tree.insert(route.pattern, route)
+
+	r.ensureNativeDispatch(nil, route.pattern, route.pattern, route.method, prefix)
 
 	return r
 }
@@ -382,18 +543,28 @@ func (r *Router) AddRouteTrace(name string, pattern string, handler interface{})
 	return r.AddRouteMethod(name, pattern, handler, Trace)
 }
 
-func (r *Router) GenerateUrlByName(name string, params map[string]interface{}) (string, error) {
+func (r *Router) GenerateUrlByName(name string, params interface{}, query ...url.Values) (string, error) {
 	route, exists := r.routes[name]
 
 	if !exists {
 		return "", errors.New(fmt.Sprintf("route with name %s not found", name))
 	}
 
-	return r.GenerateUrlByPattern(route.pattern, params)
+	return r.GenerateUrlByPattern(route.pattern, params, query...)
+}
+
+func (r *Router) GenerateUrlByPattern(pattern string, params interface{}, query ...url.Values) (string, error) {
+	return GenerateUrlByPattern(pattern, params, query...)
 }
 
-func (r *Router) GenerateUrlByPattern(pattern string, params map[string]interface{}) (string, error) {
-	return GenerateUrlByPattern(pattern, params)
+// MustURL is like GenerateUrlByName but panics on error, for use in contexts
+// such as templates where a route name and its params are known to be valid.
+func (r *Router) MustURL(name string, params interface{}, query ...url.Values) string {
+	u, err := r.GenerateUrlByName(name, params, query...)
+	if err != nil {
+		panic(err)
+	}
+	return u
 }
 
 // GetNativeRouter return gin router engine
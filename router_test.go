@@ -29,16 +29,16 @@ func TestRouterErrorHandlers(t *testing.T) {
 	})
 
 	// Definice rout pro testování
-	router.AddRouteGet("/ok", func(c *gin.Context) {
+	router.AddRouteGet("ok", "/ok", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{"message": "This is OK"})
 	})
-	router.AddRouteGet("/notfound", func(c *gin.Context) {
+	router.AddRouteGet("notfound", "/notfound", func(c *gin.Context) {
 		c.Status(http.StatusNotFound)
 		c.JSON(http.StatusNotFound, gin.H{
 			"error": "Custom 404",
 		})
 	})
-	router.AddRouteGet("/servererror", func(c *gin.Context) {
+	router.AddRouteGet("servererror", "/servererror", func(c *gin.Context) {
 		c.Status(http.StatusInternalServerError)
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "Custom 500",
@@ -109,8 +109,9 @@ func TestRouter_AddRoute(t *testing.T) {
 	}
 
 	// Přidání routy
-	router.AddRoute("/:locale/products/:id", productDetailHandler, Get)
+	router.AddRoute("products:detail", "/:locale/products/:id", productDetailHandler, Get)
 	router.AddRoute(
+		"product:detail",
 		"/product/:id",
 		func(c *gin.Context, p *struct {
 			ID int `uri:"id" binding:"required"`
@@ -157,7 +158,7 @@ func TestRouter_AddRouteWithoutParams(t *testing.T) {
 	}
 
 	// Přidání routy
-	router.AddRoute("/:locale/products/:id", productDetailHandler, Get)
+	router.AddRoute("products:detail", "/:locale/products/:id", productDetailHandler, Get)
 
 	// Testování požadavku
 	req := httptest.NewRequest(http.MethodGet, "/cs/products/42", nil)
@@ -186,14 +187,14 @@ func TestNewRouteList(t *testing.T) {
 
 	lr := NewRouteList()
 	v1 := CreateRouteList("/v1")
-	lr.addChild(v1)
+	lr.AddChild(v1)
 
-	lr.Add("/:locale/products/:id", productDetailHandler, Get)
-	v1.Add("/:locale/products/:id", productDetailHandler, Get)
+	lr.Add("products:detail", "/:locale/products/:id", productDetailHandler, Get)
+	v1.Add("products:detail", "/:locale/products/:id", productDetailHandler, Get)
 
 	router := NewRouter()
 	router.AddRouteList(lr)
-	CreateRoute(router, "/test/:id", func(c *gin.Context, p *struct {
+	CreateRoute(router, "test", "/test/:id", func(c *gin.Context, p *struct {
 		ID int `uri:"id" binding:"required"`
 	}) {
 		c.JSON(http.StatusOK, gin.H{
@@ -0,0 +1,278 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Params holds the path parameters resolved during a tree lookup.
+type Params map[string]string
+
+func (p Params) clone() Params {
+	clone := make(Params, len(p)+1)
+	for k, v := range p {
+		clone[k] = v
+	}
+	return clone
+}
+
+// ErrRouteNotFound is returned by Router.Match when no route matches the path.
+var ErrRouteNotFound = errors.New("route not found")
+
+// MethodNotAllowedError is returned by Router.Match when the path matches a
+// registered route but not for the requested method.
+type MethodNotAllowedError struct {
+	Allowed []string
+}
+
+func (e *MethodNotAllowedError) Error() string {
+	return fmt.Sprintf("method not allowed, allowed: %s", strings.Join(e.Allowed, ", "))
+}
+
+// node is a single segment of the radix route tree. Each node keeps its
+// literal children keyed by segment, plus at most one param, regex and
+// wildcard child, matching lookup priority: literal, param, regex, wildcard.
+type node struct {
+	children   map[string]*node
+	paramChild *node
+	paramName  string
+
+	regexChild *node
+	regexName  string
+	regex      *regexp.Regexp
+
+	wildcardChild *node
+	wildcardName  string
+
+	// handlers holds, per method, every route registered at this node in
+	// registration order. Most nodes hold at most one route per method; a
+	// slice is only needed so routes distinguished purely by a Host/Headers/
+	// Queries/Schemes matcher (see Route.hasMatchers) can share a path+method
+	// and be told apart at request time instead of colliding.
+	handlers map[Method][]*Route
+}
+
+func newNode() *node {
+	return &node{children: make(map[string]*node)}
+}
+
+// splitPattern splits a route pattern into its non-empty path segments.
+func splitPattern(pattern string) []string {
+	trimmed := strings.Trim(pattern, "/")
+	if trimmed == "" {
+		return nil
+	}
+	return strings.Split(trimmed, "/")
+}
+
+// insert registers route under pattern, creating intermediate nodes as needed.
+func (n *node) insert(pattern string, route *Route) {
+	cur := n
+	segments := splitPattern(pattern)
+
+	for _, seg := range segments {
+		switch {
+		case strings.HasPrefix(seg, "*"):
+			name := seg[1:]
+			if cur.wildcardChild == nil {
+				cur.wildcardChild = newNode()
+				cur.wildcardName = name
+			}
+			cur = cur.wildcardChild
+		case strings.HasPrefix(seg, ":"):
+			spec := seg[1:]
+			if name, pattern, ok := strings.Cut(spec, "|"); ok {
+				if cur.regexChild == nil {
+					cur.regexChild = newNode()
+					cur.regexName = name
+					cur.regex = regexp.MustCompile("^" + pattern + "$")
+				}
+				cur = cur.regexChild
+			} else {
+				if cur.paramChild == nil {
+					cur.paramChild = newNode()
+					cur.paramName = spec
+				}
+				cur = cur.paramChild
+			}
+		default:
+			child, ok := cur.children[seg]
+			if !ok {
+				child = newNode()
+				cur.children[seg] = child
+			}
+			cur = child
+		}
+	}
+
+	if cur.handlers == nil {
+		cur.handlers = make(map[Method][]*Route)
+	}
+	cur.handlers[route.method] = append(cur.handlers[route.method], route)
+}
+
+// search walks segments starting at idx, backtracking through literal, param,
+// regex and wildcard children in that priority order until it finds a node
+// with handlers, or exhausts every candidate.
+func (n *node) search(segments []string, idx int, params Params) (*node, Params, bool) {
+	if idx == len(segments) {
+		if n.handlers != nil {
+			return n, params, true
+		}
+		return nil, nil, false
+	}
+
+	seg := segments[idx]
+
+	if child, ok := n.children[seg]; ok {
+		if found, p, ok := child.search(segments, idx+1, params); ok {
+			return found, p, true
+		}
+	}
+
+	if n.paramChild != nil {
+		p := params.clone()
+		p[n.paramName] = seg
+		if found, p, ok := n.paramChild.search(segments, idx+1, p); ok {
+			return found, p, true
+		}
+	}
+
+	if n.regexChild != nil && n.regex.MatchString(seg) {
+		p := params.clone()
+		p[n.regexName] = seg
+		if found, p, ok := n.regexChild.search(segments, idx+1, p); ok {
+			return found, p, true
+		}
+	}
+
+	if n.wildcardChild != nil {
+		p := params.clone()
+		p[n.wildcardName] = strings.Join(segments[idx:], "/")
+		if n.wildcardChild.handlers != nil {
+			return n.wildcardChild, p, true
+		}
+	}
+
+	return nil, nil, false
+}
+
+// walk visits every route registered anywhere under n, in no particular order.
+func (n *node) walk(fn func(route *Route)) {
+	for _, routes := range n.handlers {
+		for _, route := range routes {
+			fn(route)
+		}
+	}
+	for _, child := range n.children {
+		child.walk(fn)
+	}
+	if n.paramChild != nil {
+		n.paramChild.walk(fn)
+	}
+	if n.regexChild != nil {
+		n.regexChild.walk(fn)
+	}
+	if n.wildcardChild != nil {
+		n.wildcardChild.walk(fn)
+	}
+}
+
+// searchInsensitive behaves like search but compares literal segments
+// case-insensitively, accumulating the canonical (registered) casing of each
+// segment it passes through so the caller can rebuild a corrected path.
+func (n *node) searchInsensitive(segments []string, idx int, resolved []string) ([]string, bool) {
+	if idx == len(segments) {
+		if n.handlers != nil {
+			return resolved, true
+		}
+		return nil, false
+	}
+
+	seg := segments[idx]
+	lower := strings.ToLower(seg)
+
+	for key, child := range n.children {
+		if strings.ToLower(key) != lower {
+			continue
+		}
+		next := append(append([]string{}, resolved...), key)
+		if found, ok := child.searchInsensitive(segments, idx+1, next); ok {
+			return found, true
+		}
+	}
+
+	if n.paramChild != nil {
+		next := append(append([]string{}, resolved...), seg)
+		if found, ok := n.paramChild.searchInsensitive(segments, idx+1, next); ok {
+			return found, true
+		}
+	}
+
+	if n.regexChild != nil && n.regex.MatchString(seg) {
+		next := append(append([]string{}, resolved...), seg)
+		if found, ok := n.regexChild.searchInsensitive(segments, idx+1, next); ok {
+			return found, true
+		}
+	}
+
+	if n.wildcardChild != nil && n.wildcardChild.handlers != nil {
+		return append(append([]string{}, resolved...), segments[idx:]...), true
+	}
+
+	return nil, false
+}
+
+// matchInsensitive resolves path against the tree ignoring case on literal
+// segments, returning the canonical path of the matching route if any.
+func (n *node) matchInsensitive(path string) (string, bool) {
+	segments := splitPattern(path)
+
+	resolved, ok := n.searchInsensitive(segments, 0, nil)
+	if !ok {
+		return "", false
+	}
+
+	return "/" + strings.Join(resolved, "/"), true
+}
+
+// match looks up method+path against the tree, distinguishing a missing path
+// (ErrRouteNotFound) from a path that exists under a different method
+// (MethodNotAllowedError). It models path+method only: when multiple routes
+// share a path+method and are distinguished solely by a Host/Headers/
+// Queries/Schemes matcher, match returns whichever was registered first. Use
+// matchAccept to select among them against a live request instead.
+func (n *node) match(method Method, path string) (*Route, Params, error) {
+	return n.matchAccept(method, path, nil)
+}
+
+// matchAccept behaves like match, but among the routes registered for
+// method+path it returns the first for which accept returns true (accept
+// nil accepts every route, matching match's behavior).
+func (n *node) matchAccept(method Method, path string, accept func(*Route) bool) (*Route, Params, error) {
+	segments := splitPattern(path)
+
+	found, params, ok := n.search(segments, 0, Params{})
+	if !ok {
+		return nil, nil, ErrRouteNotFound
+	}
+
+	routes, ok := found.handlers[method]
+	if !ok {
+		allowed := make([]string, 0, len(found.handlers))
+		for m := range found.handlers {
+			allowed = append(allowed, m.String())
+		}
+		return nil, nil, &MethodNotAllowedError{Allowed: allowed}
+	}
+
+	for _, route := range routes {
+		if accept == nil || accept(route) {
+			return route, params, nil
+		}
+	}
+
+	return nil, nil, ErrRouteNotFound
+}
@@ -0,0 +1,122 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouter_Match(t *testing.T) {
+	r := NewRouter()
+	r.AddRoute("product:detail", "/products/:id", func(c *gin.Context) {}, Get)
+	r.AddRoute("category:detail", "/categories/:slug|[a-z]+", func(c *gin.Context) {}, Get)
+	r.AddRoute("assets", "/assets/*path", func(c *gin.Context) {}, Get)
+
+	route, params, err := r.Match(http.MethodGet, "/products/42")
+	assert.NoError(t, err)
+	assert.Equal(t, "product:detail", route.GetName())
+	assert.Equal(t, "42", params["id"])
+
+	route, params, err = r.Match(http.MethodGet, "/categories/books")
+	assert.NoError(t, err)
+	assert.Equal(t, "category:detail", route.GetName())
+	assert.Equal(t, "books", params["slug"])
+
+	_, _, err = r.Match(http.MethodGet, "/categories/42")
+	assert.ErrorIs(t, err, ErrRouteNotFound)
+
+	route, params, err = r.Match(http.MethodGet, "/assets/css/app.css")
+	assert.NoError(t, err)
+	assert.Equal(t, "assets", route.GetName())
+	assert.Equal(t, "css/app.css", params["path"])
+
+	_, _, err = r.Match(http.MethodGet, "/unknown")
+	assert.ErrorIs(t, err, ErrRouteNotFound)
+
+	_, _, err = r.Match(http.MethodDelete, "/products/42")
+	var methodErr *MethodNotAllowedError
+	assert.ErrorAs(t, err, &methodErr)
+	assert.Contains(t, methodErr.Allowed, http.MethodGet)
+}
+
+func TestRouter_Match_IgnoresMatchersAndReturnsFirstRegistered(t *testing.T) {
+	r := NewRouter()
+	r.AddRouteObject(NewRoute("admin:home", "/home", func(c *gin.Context) {}, Get, map[string]*Route{}).Host("admin.example.com"))
+	r.AddRouteObject(NewRoute("shop:home", "/home", func(c *gin.Context) {}, Get, map[string]*Route{}).Host("shop.example.com"))
+
+	route, _, err := r.Match(http.MethodGet, "/home")
+	assert.NoError(t, err)
+	assert.Equal(t, "admin:home", route.GetName())
+}
+
+func TestRouter_Group(t *testing.T) {
+	r := NewRouter()
+
+	v1 := r.Group("/v1")
+	v1.AddRouteGet("v1:ping", "/ping", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "pong"})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/ping", nil)
+	w := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"message":"pong"}`, w.Body.String())
+
+	route, _, err := r.Match(http.MethodGet, "/v1/ping")
+	assert.NoError(t, err)
+	assert.Equal(t, "v1:ping", route.GetName())
+}
+
+func TestRoute_Host(t *testing.T) {
+	r := NewRouter()
+	r.AddRouteObject(NewRoute("admin:home", "/home", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"area": "admin"})
+	}, Get, map[string]*Route{}).Host("admin.example.com"))
+
+	req := httptest.NewRequest(http.MethodGet, "/home", nil)
+	req.Host = "admin.example.com"
+	w := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	req2 := httptest.NewRequest(http.MethodGet, "/home", nil)
+	req2.Host = "other.example.com"
+	w2 := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w2, req2)
+	assert.Equal(t, http.StatusNotFound, w2.Code)
+}
+
+func TestRoute_HostMultiplexesSamePath(t *testing.T) {
+	r := NewRouter()
+	r.AddRouteObject(NewRoute("admin:home", "/home", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"area": "admin"})
+	}, Get, map[string]*Route{}).Host("admin.example.com"))
+	r.AddRouteObject(NewRoute("shop:home", "/home", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"area": "shop"})
+	}, Get, map[string]*Route{}).Host("shop.example.com"))
+
+	req := httptest.NewRequest(http.MethodGet, "/home", nil)
+	req.Host = "admin.example.com"
+	w := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"area":"admin"}`, w.Body.String())
+
+	req2 := httptest.NewRequest(http.MethodGet, "/home", nil)
+	req2.Host = "shop.example.com"
+	w2 := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w2, req2)
+	assert.Equal(t, http.StatusOK, w2.Code)
+	assert.JSONEq(t, `{"area":"shop"}`, w2.Body.String())
+
+	req3 := httptest.NewRequest(http.MethodGet, "/home", nil)
+	req3.Host = "other.example.com"
+	w3 := httptest.NewRecorder()
+	r.GetNativeRouter().ServeHTTP(w3, req3)
+	assert.Equal(t, http.StatusNotFound, w3.Code)
+}
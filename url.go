@@ -0,0 +1,166 @@
+package router
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// GenerateUrlByPattern builds a URL from pattern, substituting its
+// ":name", ":name?" (optional), ":name|regex" (regex-constrained) and
+// "*name" (catch-all) segments.
+//
+// params is either a map[string]interface{} keyed by segment name, or a
+// struct (or pointer to struct) whose fields tagged `uri:"name"` supply the
+// segment values and whose fields tagged `query:"name"` are appended as a
+// query string. Any query passed explicitly is merged on top of tag-derived
+// values and, when non-empty, appended as a sorted, percent-encoded query
+// string.
+//
+// Example:
+//
+//	GenerateUrlByPattern("/products/:id", map[string]interface{}{"id": 42})
+//	GenerateUrlByPattern("/products/:id", ProductParams{ID: 42, Verbose: true})
+func GenerateUrlByPattern(pattern string, params interface{}, query ...url.Values) (string, error) {
+	values, extraQuery, err := paramsToValues(params)
+	if err != nil {
+		return "", err
+	}
+
+	mergedQuery := mergeQuery(extraQuery, query...)
+
+	return buildUrl(pattern, values, mergedQuery)
+}
+
+// paramsToValues normalizes params into a segment-name map plus any query
+// values carried by `query:"..."` struct tags.
+func paramsToValues(params interface{}) (map[string]interface{}, url.Values, error) {
+	switch p := params.(type) {
+	case nil:
+		return map[string]interface{}{}, nil, nil
+	case map[string]interface{}:
+		return p, nil, nil
+	default:
+		return structToValues(params)
+	}
+}
+
+// structToValues reflects over a struct (or pointer to struct), collecting
+// `uri` tagged fields as path params and `query` tagged fields as query
+// values. A `query` tagged field holding its type's zero value is omitted,
+// so an unset DTO field doesn't show up in the built URL as e.g. "sort=".
+func structToValues(v interface{}) (map[string]interface{}, url.Values, error) {
+	val := reflect.ValueOf(v)
+	for val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return nil, nil, fmt.Errorf("params must be a map[string]interface{} or a struct, got %T", v)
+	}
+
+	params := make(map[string]interface{})
+	query := make(url.Values)
+
+	t := val.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldValue := val.Field(i)
+		if !fieldValue.CanInterface() {
+			continue
+		}
+
+		if tag, ok := field.Tag.Lookup("uri"); ok {
+			name, _, _ := strings.Cut(tag, ",")
+			params[name] = fieldValue.Interface()
+		}
+
+		if tag, ok := field.Tag.Lookup("query"); ok {
+			if fieldValue.IsZero() {
+				continue
+			}
+			name, _, _ := strings.Cut(tag, ",")
+			query.Set(name, fmt.Sprintf("%v", fieldValue.Interface()))
+		}
+	}
+
+	return params, query, nil
+}
+
+// mergeQuery layers any explicitly passed query Values on top of tag-derived
+// values, explicit values winning on key collisions.
+func mergeQuery(base url.Values, extra ...url.Values) url.Values {
+	merged := make(url.Values, len(base))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for _, q := range extra {
+		for k, v := range q {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+// buildUrl substitutes pattern's segments from values and appends query.
+func buildUrl(pattern string, values map[string]interface{}, query url.Values) (string, error) {
+	var b strings.Builder
+
+	for _, seg := range splitPattern(pattern) {
+		switch {
+		case strings.HasPrefix(seg, "*"):
+			name := seg[1:]
+			value, ok := values[name]
+			if !ok {
+				return "", fmt.Errorf("missing value for parameter: %s", name)
+			}
+			b.WriteString("/")
+			b.WriteString(fmt.Sprintf("%v", value))
+
+		case strings.HasPrefix(seg, ":"):
+			spec := strings.TrimPrefix(seg, ":")
+			optional := strings.HasSuffix(spec, "?")
+			spec = strings.TrimSuffix(spec, "?")
+
+			name, pattern, hasRegex := strings.Cut(spec, "|")
+
+			value, ok := values[name]
+			if !ok {
+				if optional {
+					continue
+				}
+				return "", fmt.Errorf("missing value for parameter: %s", name)
+			}
+
+			strValue := fmt.Sprintf("%v", value)
+			if hasRegex {
+				re, err := regexp.Compile("^" + pattern + "$")
+				if err != nil {
+					return "", fmt.Errorf("invalid regex for parameter %s: %w", name, err)
+				}
+				if !re.MatchString(strValue) {
+					return "", fmt.Errorf("value %q for parameter %s does not match pattern %s", strValue, name, pattern)
+				}
+			}
+
+			b.WriteString("/")
+			b.WriteString(strValue)
+
+		default:
+			b.WriteString("/")
+			b.WriteString(seg)
+		}
+	}
+
+	result := b.String()
+	if result == "" {
+		result = "/"
+	}
+
+	if len(query) > 0 {
+		result += "?" + query.Encode()
+	}
+
+	return result, nil
+}
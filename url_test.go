@@ -0,0 +1,99 @@
+package router
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateUrlByPattern_Map(t *testing.T) {
+	u, err := GenerateUrlByPattern("/products/:id", map[string]interface{}{"id": 42})
+	assert.NoError(t, err)
+	assert.Equal(t, "/products/42", u)
+
+	_, err = GenerateUrlByPattern("/products/:id", map[string]interface{}{})
+	assert.Error(t, err)
+}
+
+func TestGenerateUrlByPattern_Wildcard(t *testing.T) {
+	u, err := GenerateUrlByPattern("/assets/*path", map[string]interface{}{"path": "css/app.css"})
+	assert.NoError(t, err)
+	assert.Equal(t, "/assets/css/app.css", u)
+}
+
+func TestGenerateUrlByPattern_Regex(t *testing.T) {
+	u, err := GenerateUrlByPattern("/categories/:slug|[a-z]+", map[string]interface{}{"slug": "books"})
+	assert.NoError(t, err)
+	assert.Equal(t, "/categories/books", u)
+
+	_, err = GenerateUrlByPattern("/categories/:slug|[a-z]+", map[string]interface{}{"slug": "42"})
+	assert.Error(t, err)
+}
+
+func TestGenerateUrlByPattern_Optional(t *testing.T) {
+	u, err := GenerateUrlByPattern("/products/:id?", map[string]interface{}{})
+	assert.NoError(t, err)
+	assert.Equal(t, "/products", u)
+
+	u, err = GenerateUrlByPattern("/products/:id?", map[string]interface{}{"id": 42})
+	assert.NoError(t, err)
+	assert.Equal(t, "/products/42", u)
+}
+
+func TestGenerateUrlByPattern_Query(t *testing.T) {
+	u, err := GenerateUrlByPattern("/products/:id", map[string]interface{}{"id": 42}, url.Values{"sort": []string{"asc"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "/products/42?sort=asc", u)
+}
+
+type productParams struct {
+	ID      int    `uri:"id"`
+	Sort    string `query:"sort"`
+	Verbose bool   `query:"verbose"`
+}
+
+func TestGenerateUrlByPattern_Struct(t *testing.T) {
+	u, err := GenerateUrlByPattern("/products/:id", productParams{ID: 42, Sort: "asc", Verbose: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "/products/42?sort=asc&verbose=true", u)
+}
+
+func TestGenerateUrlByPattern_StructOmitsZeroValueQueryFields(t *testing.T) {
+	u, err := GenerateUrlByPattern("/products/:id", productParams{ID: 42})
+	assert.NoError(t, err)
+	assert.Equal(t, "/products/42", u)
+}
+
+func TestRouter_GenerateUrlByName(t *testing.T) {
+	r := NewRouter()
+	r.AddRoute("product:detail", "/products/:id", func(c *gin.Context) {}, Get)
+
+	u, err := r.GenerateUrlByName("product:detail", map[string]interface{}{"id": 42})
+	assert.NoError(t, err)
+	assert.Equal(t, "/products/42", u)
+
+	_, err = r.GenerateUrlByName("unknown", map[string]interface{}{"id": 42})
+	assert.Error(t, err)
+}
+
+func TestRouter_MustURL(t *testing.T) {
+	r := NewRouter()
+	r.AddRoute("product:detail", "/products/:id", func(c *gin.Context) {}, Get)
+
+	assert.Equal(t, "/products/42", r.MustURL("product:detail", map[string]interface{}{"id": 42}))
+	assert.Panics(t, func() {
+		r.MustURL("unknown", map[string]interface{}{"id": 42})
+	})
+}
+
+func TestRoute_URL(t *testing.T) {
+	r := NewRouter()
+	r.AddRoute("product:detail", "/products/:id", func(c *gin.Context) {}, Get)
+	route := r.GetRoutes()["product:detail"]
+
+	u, err := route.URL(map[string]interface{}{"id": 42})
+	assert.NoError(t, err)
+	assert.Equal(t, "/products/42", u)
+}